@@ -0,0 +1,49 @@
+//go:build cbor
+
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nullable
+
+import (
+	"github.com/fxamacker/cbor/v2"
+	"github.com/rlshukhov/nullable/internal/convert"
+)
+
+// cborNil is the CBOR wire-format byte for the nil (null) major type.
+const cborNil = 0xf6
+
+// MarshalCBOR implements the cbor.Marshaler interface from github.com/fxamacker/cbor/v2.
+// A null Nullable encodes to the CBOR nil token.
+func (n Nullable[T]) MarshalCBOR() ([]byte, error) {
+	if !n.valid {
+		return cbor.Marshal(nil)
+	}
+	return cbor.Marshal(n.value)
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface from
+// github.com/fxamacker/cbor/v2. The CBOR nil token results in a null Nullable without
+// allocating a T.
+func (n *Nullable[T]) UnmarshalCBOR(data []byte) error {
+	if len(data) > 0 && data[0] == cborNil {
+		n.value = convert.ZeroValue[T]()
+		n.valid = false
+		return nil
+	}
+
+	var value T
+	if err := cbor.Unmarshal(data, &value); err != nil {
+		n.valid = false
+		return err
+	}
+
+	n.value = value
+	n.valid = true
+	return nil
+}
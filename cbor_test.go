@@ -0,0 +1,43 @@
+//go:build cbor
+
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nullable
+
+import (
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNullable_CBOR(t *testing.T) {
+	t.Run("round-trip with valid value", func(t *testing.T) {
+		n := FromValue(42)
+		data, err := cbor.Marshal(n)
+		assert.NoError(t, err)
+
+		var decoded Nullable[int]
+		err = cbor.Unmarshal(data, &decoded)
+		assert.NoError(t, err)
+		assert.True(t, decoded.valid)
+		assert.Equal(t, 42, decoded.value)
+	})
+
+	t.Run("round-trip with null", func(t *testing.T) {
+		n := Null[string]()
+		data, err := cbor.Marshal(n)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{cborNil}, data)
+
+		var decoded Nullable[string]
+		err = cbor.Unmarshal(data, &decoded)
+		assert.NoError(t, err)
+		assert.False(t, decoded.valid)
+	})
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nullable
+
+// Map applies f to n's value and returns the result wrapped in a Nullable, or Null[U]()
+// if n is null. Package-level because methods cannot introduce new type parameters.
+func Map[T, U any](n Nullable[T], f func(T) U) Nullable[U] {
+	if n.IsNull() {
+		return Null[U]()
+	}
+	return FromValue(f(n.GetValue()))
+}
+
+// FlatMap applies f to n's value and returns its result directly, or Null[U]() if n is null.
+func FlatMap[T, U any](n Nullable[T], f func(T) Nullable[U]) Nullable[U] {
+	if n.IsNull() {
+		return Null[U]()
+	}
+	return f(n.GetValue())
+}
+
+// Filter returns n unchanged if n is valid and pred(n.GetValue()) is true; otherwise it
+// returns Null[T]().
+func Filter[T any](n Nullable[T], pred func(T) bool) Nullable[T] {
+	if n.IsNull() || !pred(n.GetValue()) {
+		return Null[T]()
+	}
+	return n
+}
+
+// Equal reports whether n and other are both null, or both valid with eq returning true
+// for their values.
+func (n Nullable[T]) Equal(other Nullable[T], eq func(a, b T) bool) bool {
+	if n.valid != other.valid {
+		return false
+	}
+	if !n.valid {
+		return true
+	}
+	return eq(n.value, other.value)
+}
+
+// EqualComparable reports whether a and b are both null, or both valid with equal values,
+// using T's == operator.
+func EqualComparable[T comparable](a, b Nullable[T]) bool {
+	return a.Equal(b, func(x, y T) bool { return x == y })
+}
+
+// Ptr returns a pointer to the value, or nil if n is null, complementing FromPointer.
+func (n Nullable[T]) Ptr() *T {
+	if !n.valid {
+		return nil
+	}
+	v := n.value
+	return &v
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+// Package convert holds the type conversion helpers shared by the nullable
+// package and its sibling subpackages (such as nullable/zero). It is not part
+// of the public API.
+package convert
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ErrUnsupportedConversion occurs when attempting to convert a value to an unsupported type.
+var ErrUnsupportedConversion = errors.New("unsupported type conversion")
+
+// Mode controls how ToTypeWithMode handles a value whose type does not exactly match
+// the requested target type.
+type Mode int
+
+const (
+	// Strict requires an exact type match. This is the original, default behavior.
+	Strict Mode = iota
+	// Lossless allows conversion between numeric kinds, but only when the source value
+	// fits in the target type's range and, for float-to-int conversions, has no
+	// fractional part.
+	Lossless
+	// Convertible allows any conversion between numeric kinds via reflect.Value.Convert,
+	// without bounds or fractional-part checks.
+	Convertible
+)
+
+// ToDriverValue converts a value to driver.Value for use with databases.
+func ToDriverValue(v any) (driver.Value, error) {
+	if valuer, ok := v.(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return nil, nil
+		}
+		return ToDriverValue(rv.Elem().Interface())
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return int64(rv.Uint()), nil
+
+	case reflect.Uint64:
+		u64 := rv.Uint()
+		if u64 >= 1<<63 {
+			return nil, fmt.Errorf("uint64 values with high bit set are not supported")
+		}
+		return int64(u64), nil
+
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+
+	case reflect.Bool:
+		return rv.Bool(), nil
+
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Bytes(), nil
+		}
+		return nil, fmt.Errorf("unsupported slice type: %s", rv.Type().Elem().Kind())
+
+	case reflect.String:
+		return rv.String(), nil
+
+	case reflect.Struct:
+		if t, ok := v.(time.Time); ok {
+			return t, nil
+		}
+		return nil, fmt.Errorf("unsupported struct type: %s", rv.Type())
+
+	default:
+		return nil, fmt.Errorf("unsupported type: %T", v)
+	}
+}
+
+// ToType attempts to convert a value to type T, requiring an exact type match.
+func ToType[T any](value any) (T, error) {
+	return ToTypeWithMode[T](value, Strict)
+}
+
+// ZeroValue returns the zero value for type T.
+func ZeroValue[T any]() T {
+	var zero T
+	return zero
+}
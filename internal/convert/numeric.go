@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package convert
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// ToTypeWithMode attempts to convert a value to type T.
+// With mode Strict, only an exact type match succeeds. With Lossless or Convertible, a
+// value of a different numeric kind is converted via reflect.Value.Convert; Lossless
+// additionally checks that the value fits in T's range and, for float-to-int
+// conversions, has no fractional part.
+func ToTypeWithMode[T any](value any, mode Mode) (T, error) {
+	var zero T
+	if value == nil {
+		return zero, nil
+	}
+
+	valueType := reflect.TypeOf(value)
+	targetType := reflect.TypeOf(zero)
+	if valueType == targetType {
+		return value.(T), nil
+	}
+
+	if mode == Strict {
+		return zero, ErrUnsupportedConversion
+	}
+
+	rv := reflect.ValueOf(value)
+	if !isNumericKind(rv.Kind()) || !isNumericKind(targetType.Kind()) {
+		return zero, ErrUnsupportedConversion
+	}
+
+	if mode == Lossless {
+		if err := checkNumericBounds(rv, targetType); err != nil {
+			return zero, err
+		}
+	}
+
+	return rv.Convert(targetType).Interface().(T), nil
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkNumericBounds reports whether rv's value fits in targetType without loss.
+func checkNumericBounds(rv reflect.Value, targetType reflect.Type) error {
+	switch targetType.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return checkSignedBounds(rv, targetType)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return checkUnsignedBounds(rv, targetType)
+	case reflect.Float32:
+		return checkFloat32Bounds(rv, targetType)
+	case reflect.Float64:
+		return checkFloat64Bounds(rv, targetType)
+	default:
+		return nil
+	}
+}
+
+func checkSignedBounds(rv reflect.Value, targetType reflect.Type) error {
+	min, max := int64(math.MinInt64), int64(math.MaxInt64)
+	if bits := targetType.Bits(); bits < 64 {
+		max = int64(1)<<(bits-1) - 1
+		min = -max - 1
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v := rv.Int(); v < min || v > max {
+			return fmt.Errorf("%w: %d overflows %s", ErrUnsupportedConversion, v, targetType)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v := rv.Uint(); v > uint64(max) {
+			return fmt.Errorf("%w: %d overflows %s", ErrUnsupportedConversion, v, targetType)
+		}
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if f != math.Trunc(f) {
+			return fmt.Errorf("%w: %v has a fractional part, cannot convert to %s", ErrUnsupportedConversion, f, targetType)
+		}
+		if f < float64(min) || f >= -float64(min) {
+			return fmt.Errorf("%w: %v overflows %s", ErrUnsupportedConversion, f, targetType)
+		}
+	}
+	return nil
+}
+
+func checkUnsignedBounds(rv reflect.Value, targetType reflect.Type) error {
+	max := uint64(math.MaxUint64)
+	if bits := targetType.Bits(); bits < 64 {
+		max = uint64(1)<<bits - 1
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v := rv.Int()
+		if v < 0 {
+			return fmt.Errorf("%w: %d is negative, cannot convert to %s", ErrUnsupportedConversion, v, targetType)
+		}
+		if uint64(v) > max {
+			return fmt.Errorf("%w: %d overflows %s", ErrUnsupportedConversion, v, targetType)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v := rv.Uint(); v > max {
+			return fmt.Errorf("%w: %d overflows %s", ErrUnsupportedConversion, v, targetType)
+		}
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if f != math.Trunc(f) {
+			return fmt.Errorf("%w: %v has a fractional part, cannot convert to %s", ErrUnsupportedConversion, f, targetType)
+		}
+		if f < 0 || f >= float64(max)+1 {
+			return fmt.Errorf("%w: %v overflows %s", ErrUnsupportedConversion, f, targetType)
+		}
+	}
+	return nil
+}
+
+// checkFloat32Bounds rejects float64 values that overflow float32 and integers that
+// float32 cannot represent exactly, since float32 only has 23 bits of mantissa.
+func checkFloat32Bounds(rv reflect.Value, targetType reflect.Type) error {
+	switch rv.Kind() {
+	case reflect.Float64:
+		if f := rv.Float(); f > math.MaxFloat32 || f < -math.MaxFloat32 {
+			return fmt.Errorf("%w: %v overflows %s", ErrUnsupportedConversion, f, targetType)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v := rv.Int(); float32(v) >= 0x1p63 || int64(float32(v)) != v {
+			return fmt.Errorf("%w: %d cannot be represented exactly as %s", ErrUnsupportedConversion, v, targetType)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v := rv.Uint(); float32(v) >= 0x1p64 || uint64(float32(v)) != v {
+			return fmt.Errorf("%w: %d cannot be represented exactly as %s", ErrUnsupportedConversion, v, targetType)
+		}
+	}
+	return nil
+}
+
+// checkFloat64Bounds rejects integers that float64 cannot represent exactly, since
+// float64 only has 52 bits of mantissa.
+func checkFloat64Bounds(rv reflect.Value, targetType reflect.Type) error {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v := rv.Int(); float64(v) >= 0x1p63 || int64(float64(v)) != v {
+			return fmt.Errorf("%w: %d cannot be represented exactly as %s", ErrUnsupportedConversion, v, targetType)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v := rv.Uint(); float64(v) >= 0x1p64 || uint64(float64(v)) != v {
+			return fmt.Errorf("%w: %d cannot be represented exactly as %s", ErrUnsupportedConversion, v, targetType)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package convert
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// ToText renders v as text for the built-in kinds used by Nullable. Callers should
+// first check whether v implements encoding.TextMarshaler themselves.
+func ToText(v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return []byte(strconv.FormatInt(rv.Int(), 10)), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return []byte(strconv.FormatUint(rv.Uint(), 10)), nil
+
+	case reflect.Float32, reflect.Float64:
+		return []byte(strconv.FormatFloat(rv.Float(), 'f', -1, rv.Type().Bits())), nil
+
+	case reflect.Bool:
+		return []byte(strconv.FormatBool(rv.Bool())), nil
+
+	default:
+		return []byte(fmt.Sprint(v)), nil
+	}
+}
+
+// FromText parses data into T for the built-in kinds used by Nullable. Callers should
+// first check whether *T implements encoding.TextUnmarshaler themselves.
+func FromText[T any](data []byte) (T, error) {
+	var zero T
+	rv := reflect.ValueOf(&zero).Elem()
+
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(string(data))
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		iv, err := strconv.ParseInt(string(data), 10, rv.Type().Bits())
+		if err != nil {
+			return zero, err
+		}
+		rv.SetInt(iv)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		uv, err := strconv.ParseUint(string(data), 10, rv.Type().Bits())
+		if err != nil {
+			return zero, err
+		}
+		rv.SetUint(uv)
+
+	case reflect.Float32, reflect.Float64:
+		fv, err := strconv.ParseFloat(string(data), rv.Type().Bits())
+		if err != nil {
+			return zero, err
+		}
+		rv.SetFloat(fv)
+
+	case reflect.Bool:
+		bv, err := strconv.ParseBool(string(data))
+		if err != nil {
+			return zero, err
+		}
+		rv.SetBool(bv)
+
+	default:
+		return zero, fmt.Errorf("%w: cannot unmarshal text into %s", ErrUnsupportedConversion, rv.Type())
+	}
+
+	return zero, nil
+}
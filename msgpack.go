@@ -0,0 +1,49 @@
+//go:build msgpack
+
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nullable
+
+import (
+	"github.com/rlshukhov/nullable/internal/convert"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// msgpackNil is the msgpack wire-format byte for the nil token.
+const msgpackNil = 0xc0
+
+// MarshalMsgpack implements the msgpack.Marshaler interface from
+// github.com/vmihailenco/msgpack/v5. A null Nullable encodes to the msgpack nil token.
+func (n Nullable[T]) MarshalMsgpack() ([]byte, error) {
+	if !n.valid {
+		return msgpack.Marshal(nil)
+	}
+	return msgpack.Marshal(n.value)
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface from
+// github.com/vmihailenco/msgpack/v5. The msgpack nil token results in a null Nullable
+// without allocating a T.
+func (n *Nullable[T]) UnmarshalMsgpack(data []byte) error {
+	if len(data) > 0 && data[0] == msgpackNil {
+		n.value = convert.ZeroValue[T]()
+		n.valid = false
+		return nil
+	}
+
+	var value T
+	if err := msgpack.Unmarshal(data, &value); err != nil {
+		n.valid = false
+		return err
+	}
+
+	n.value = value
+	n.valid = true
+	return nil
+}
@@ -0,0 +1,43 @@
+//go:build msgpack
+
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nullable
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/vmihailenco/msgpack/v5"
+	"testing"
+)
+
+func TestNullable_Msgpack(t *testing.T) {
+	t.Run("round-trip with valid value", func(t *testing.T) {
+		n := FromValue(42)
+		data, err := msgpack.Marshal(n)
+		assert.NoError(t, err)
+
+		var decoded Nullable[int]
+		err = msgpack.Unmarshal(data, &decoded)
+		assert.NoError(t, err)
+		assert.True(t, decoded.valid)
+		assert.Equal(t, 42, decoded.value)
+	})
+
+	t.Run("round-trip with null", func(t *testing.T) {
+		n := Null[string]()
+		data, err := msgpack.Marshal(n)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{msgpackNil}, data)
+
+		var decoded Nullable[string]
+		err = msgpack.Unmarshal(data, &decoded)
+		assert.NoError(t, err)
+		assert.False(t, decoded.valid)
+	})
+}
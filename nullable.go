@@ -15,16 +15,12 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
-	"errors"
-	"fmt"
-	"reflect"
-	"time"
+
+	"github.com/rlshukhov/nullable/internal/convert"
 )
 
 // ErrUnsupportedConversion occurs when attempting to convert a value to an unsupported type.
-var (
-	ErrUnsupportedConversion = errors.New("unsupported type conversion")
-)
+var ErrUnsupportedConversion = convert.ErrUnsupportedConversion
 
 // Nullable represents a nullable value of any type T.
 // The value field holds the actual value of type T.
@@ -78,7 +74,7 @@ func (n Nullable[T]) HasValue() bool {
 // Scan implements the sql.Scanner interface for Nullable, allowing it to be used in database operations.
 func (n *Nullable[T]) Scan(value any) error {
 	if value == nil {
-		n.value = zeroValue[T]()
+		n.value = convert.ZeroValue[T]()
 		n.valid = false
 		return nil
 	}
@@ -96,7 +92,7 @@ func (n *Nullable[T]) Scan(value any) error {
 
 	// If T does not implement sql.Scanner, attempt type conversion
 	var err error
-	n.value, err = convertToType[T](value)
+	n.value, err = convert.ToTypeWithMode[T](value, convert.Mode(currentNumericConversion()))
 	if err != nil {
 		n.valid = false
 		return err
@@ -116,14 +112,14 @@ func (n Nullable[T]) Value() (driver.Value, error) {
 		return valuer.Value()
 	}
 
-	return convertToDriverValue(n.value)
+	return convert.ToDriverValue(n.value)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface for Nullable.
 func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
 		n.valid = false
-		n.value = zeroValue[T]()
+		n.value = convert.ZeroValue[T]()
 		return nil
 	}
 
@@ -157,7 +153,7 @@ func (n *Nullable[T]) UnmarshalYAML(unmarshal func(any) error) error {
 
 	if value == nil {
 		n.valid = false
-		n.value = zeroValue[T]()
+		n.value = convert.ZeroValue[T]()
 	} else {
 		n.value = *value
 		n.valid = true
@@ -173,79 +169,3 @@ func (n Nullable[T]) MarshalYAML() (any, error) {
 	}
 	return n.value, nil
 }
-
-// convertToDriverValue converts a value to driver.Value for use with databases.
-func convertToDriverValue(v any) (driver.Value, error) {
-	if valuer, ok := v.(driver.Valuer); ok {
-		return valuer.Value()
-	}
-
-	rv := reflect.ValueOf(v)
-	switch rv.Kind() {
-	case reflect.Pointer:
-		if rv.IsNil() {
-			return nil, nil
-		}
-		return convertToDriverValue(rv.Elem().Interface())
-
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		return rv.Int(), nil
-
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
-		return int64(rv.Uint()), nil
-
-	case reflect.Uint64:
-		u64 := rv.Uint()
-		if u64 >= 1<<63 {
-			return nil, fmt.Errorf("uint64 values with high bit set are not supported")
-		}
-		return int64(u64), nil
-
-	case reflect.Float32, reflect.Float64:
-		return rv.Float(), nil
-
-	case reflect.Bool:
-		return rv.Bool(), nil
-
-	case reflect.Slice:
-		if rv.Type().Elem().Kind() == reflect.Uint8 {
-			return rv.Bytes(), nil
-		}
-		return nil, fmt.Errorf("unsupported slice type: %s", rv.Type().Elem().Kind())
-
-	case reflect.String:
-		return rv.String(), nil
-
-	case reflect.Struct:
-		if t, ok := v.(time.Time); ok {
-			return t, nil
-		}
-		return nil, fmt.Errorf("unsupported struct type: %s", rv.Type())
-
-	default:
-		return nil, fmt.Errorf("unsupported type: %T", v)
-	}
-}
-
-// convertToType attempts to convert a value to type T.
-// In this implementation, conversion between different types, even if numeric, is disallowed to ensure strict typing.
-func convertToType[T any](value any) (T, error) {
-	var zero T
-	if value == nil {
-		return zero, nil
-	}
-
-	valueType := reflect.TypeOf(value)
-	targetType := reflect.TypeOf(zero)
-	if valueType == targetType {
-		return value.(T), nil
-	}
-
-	return zero, ErrUnsupportedConversion
-}
-
-// zeroValue returns the zero value for type T.
-func zeroValue[T any]() T {
-	var zero T
-	return zero
-}
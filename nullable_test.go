@@ -11,7 +11,9 @@ package nullable
 import (
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
+	"github.com/rlshukhov/nullable/internal/convert"
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/yaml.v3"
 	"testing"
@@ -38,6 +40,26 @@ func (t TestStruct) Value() (driver.Value, error) {
 	return t.Field, nil
 }
 
+// TestTextType is a structure used for testing MarshalText and UnmarshalText.
+type TestTextType struct {
+	Field string
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for TestTextType.
+func (t TestTextType) MarshalText() ([]byte, error) {
+	return []byte("text:" + t.Field), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for TestTextType.
+func (t *TestTextType) UnmarshalText(data []byte) error {
+	s := string(data)
+	if len(s) < 5 || s[:5] != "text:" {
+		return errors.New("missing text: prefix")
+	}
+	t.Field = s[5:]
+	return nil
+}
+
 func TestNullable_Constructors(t *testing.T) {
 	t.Run("NewNullable with value", func(t *testing.T) {
 		n := FromValue("test")
@@ -315,7 +337,7 @@ func TestNullable_EdgeCases(t *testing.T) {
 
 func TestNullable_UnsupportedConversions(t *testing.T) {
 	t.Run("convertToType with incompatible types", func(t *testing.T) {
-		_, err := convertToType[int]("string")
+		_, err := convert.ToType[int]("string")
 		assert.Error(t, err)
 	})
 
@@ -323,15 +345,359 @@ func TestNullable_UnsupportedConversions(t *testing.T) {
 		type Unsupported struct {
 			A int
 		}
-		_, err := convertToDriverValue(Unsupported{A: 1})
+		_, err := convert.ToDriverValue(Unsupported{A: 1})
 		assert.Error(t, err)
 	})
 
 	t.Run("convertToType with numeric conversion (disallowed)", func(t *testing.T) {
-		_, err := convertToType[int](float64(42.0))
+		_, err := convert.ToType[int](float64(42.0))
+		assert.Error(t, err)
+
+		_, err = convert.ToType[int](float64(42.5))
+		assert.Error(t, err)
+	})
+}
+
+func TestNullable_NumericConversion(t *testing.T) {
+	t.Run("Strict mode (default) rejects numeric widening", func(t *testing.T) {
+		var n Nullable[int32]
+		err := n.Scan(int64(42))
+		assert.Error(t, err)
+	})
+
+	t.Run("Lossless mode widens an in-range driver int64 into int32", func(t *testing.T) {
+		SetNumericConversion(Lossless)
+		defer SetNumericConversion(Strict)
+
+		var n Nullable[int32]
+		err := n.Scan(int64(42))
+		assert.NoError(t, err)
+		assert.True(t, n.valid)
+		assert.Equal(t, int32(42), n.value)
+	})
+
+	t.Run("Lossless mode rejects an out-of-range driver int64", func(t *testing.T) {
+		SetNumericConversion(Lossless)
+		defer SetNumericConversion(Strict)
+
+		var n Nullable[int32]
+		err := n.Scan(int64(1) << 40)
+		assert.Error(t, err)
+	})
+
+	t.Run("Lossless mode rejects a float64 with a fractional part", func(t *testing.T) {
+		SetNumericConversion(Lossless)
+		defer SetNumericConversion(Strict)
+
+		var n Nullable[int]
+		err := n.Scan(42.5)
+		assert.Error(t, err)
+	})
+
+	t.Run("Lossless mode accepts a whole-number float64", func(t *testing.T) {
+		SetNumericConversion(Lossless)
+		defer SetNumericConversion(Strict)
+
+		var n Nullable[int]
+		err := n.Scan(42.0)
+		assert.NoError(t, err)
+		assert.Equal(t, 42, n.value)
+	})
+
+	t.Run("Convertible mode truncates without bounds checking", func(t *testing.T) {
+		SetNumericConversion(Convertible)
+		defer SetNumericConversion(Strict)
+
+		var n Nullable[int]
+		err := n.Scan(42.9)
+		assert.NoError(t, err)
+		assert.Equal(t, 42, n.value)
+	})
+
+	t.Run("Lossless mode rejects an int64 that float64 cannot represent exactly", func(t *testing.T) {
+		SetNumericConversion(Lossless)
+		defer SetNumericConversion(Strict)
+
+		var n Nullable[float64]
+		err := n.Scan(int64(9223372036854775807))
 		assert.Error(t, err)
+	})
 
-		_, err = convertToType[int](float64(42.5))
+	t.Run("Lossless mode accepts an int64 that float64 represents exactly", func(t *testing.T) {
+		SetNumericConversion(Lossless)
+		defer SetNumericConversion(Strict)
+
+		var n Nullable[float64]
+		err := n.Scan(int64(42))
+		assert.NoError(t, err)
+		assert.Equal(t, float64(42), n.value)
+	})
+
+	t.Run("Lossless mode rejects an int64 that float32 cannot represent exactly", func(t *testing.T) {
+		SetNumericConversion(Lossless)
+		defer SetNumericConversion(Strict)
+
+		var n Nullable[float32]
+		err := n.Scan(int64(16777217))
 		assert.Error(t, err)
 	})
+
+	t.Run("Lossless mode accepts an int64 that float32 represents exactly", func(t *testing.T) {
+		SetNumericConversion(Lossless)
+		defer SetNumericConversion(Strict)
+
+		var n Nullable[float32]
+		err := n.Scan(int64(42))
+		assert.NoError(t, err)
+		assert.Equal(t, float32(42), n.value)
+	})
+}
+
+func TestNullable_Text(t *testing.T) {
+	t.Run("MarshalText with valid int", func(t *testing.T) {
+		n := FromValue(42)
+		data, err := n.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, "42", string(data))
+	})
+
+	t.Run("MarshalText with valid string", func(t *testing.T) {
+		n := FromValue("hello")
+		data, err := n.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, "hello", string(data))
+	})
+
+	t.Run("MarshalText with null", func(t *testing.T) {
+		n := Null[int]()
+		data, err := n.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, "", string(data))
+	})
+
+	t.Run("MarshalText delegates to T's TextMarshaler", func(t *testing.T) {
+		n := FromValue(TestTextType{Field: "value"})
+		data, err := n.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, "text:value", string(data))
+	})
+
+	t.Run("MarshalText with time.Time", func(t *testing.T) {
+		now := time.Now()
+		n := FromValue(now)
+		data, err := n.MarshalText()
+		assert.NoError(t, err)
+		expected, err := now.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, string(expected), string(data))
+	})
+
+	t.Run("UnmarshalText with int", func(t *testing.T) {
+		var n Nullable[int]
+		err := n.UnmarshalText([]byte("123"))
+		assert.NoError(t, err)
+		assert.True(t, n.valid)
+		assert.Equal(t, 123, n.value)
+	})
+
+	t.Run("UnmarshalText with string", func(t *testing.T) {
+		var n Nullable[string]
+		err := n.UnmarshalText([]byte("hello"))
+		assert.NoError(t, err)
+		assert.True(t, n.valid)
+		assert.Equal(t, "hello", n.value)
+	})
+
+	t.Run("UnmarshalText with empty input sets null", func(t *testing.T) {
+		var n Nullable[int]
+		err := n.UnmarshalText([]byte{})
+		assert.NoError(t, err)
+		assert.False(t, n.valid)
+	})
+
+	t.Run("UnmarshalText delegates to T's TextUnmarshaler", func(t *testing.T) {
+		var n Nullable[TestTextType]
+		err := n.UnmarshalText([]byte("text:value"))
+		assert.NoError(t, err)
+		assert.True(t, n.valid)
+		assert.Equal(t, TestTextType{Field: "value"}, n.value)
+	})
+
+	t.Run("UnmarshalText with time.Time", func(t *testing.T) {
+		var n Nullable[time.Time]
+		err := n.UnmarshalText([]byte("2024-01-02T15:04:05Z"))
+		assert.NoError(t, err)
+		assert.True(t, n.valid)
+		assert.Equal(t, 2024, n.value.Year())
+	})
+
+	t.Run("UnmarshalText with invalid numeric text", func(t *testing.T) {
+		var n Nullable[int]
+		err := n.UnmarshalText([]byte("not a number"))
+		assert.Error(t, err)
+		assert.False(t, n.valid)
+	})
+}
+
+type xmlElementDoc struct {
+	XMLName xml.Name      `xml:"doc"`
+	Field   Nullable[int] `xml:"Field"`
+}
+
+type xmlAttrDoc struct {
+	XMLName xml.Name         `xml:"doc"`
+	Field   Nullable[string] `xml:"field,attr"`
+}
+
+func TestNullable_XML(t *testing.T) {
+	t.Run("MarshalXML with valid value", func(t *testing.T) {
+		data, err := xml.Marshal(xmlElementDoc{Field: FromValue(42)})
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "<Field>42</Field>")
+	})
+
+	t.Run("MarshalXML with null emits xsi:nil", func(t *testing.T) {
+		data, err := xml.Marshal(xmlElementDoc{Field: Null[int]()})
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), `xsi:nil="true"`)
+	})
+
+	t.Run("UnmarshalXML with xsi:nil attribute", func(t *testing.T) {
+		var doc xmlElementDoc
+		err := xml.Unmarshal([]byte(`<doc><Field xsi:nil="true"></Field></doc>`), &doc)
+		assert.NoError(t, err)
+		assert.False(t, doc.Field.valid)
+	})
+
+	t.Run("UnmarshalXML with properly namespaced xsi:nil attribute", func(t *testing.T) {
+		var doc xmlElementDoc
+		err := xml.Unmarshal([]byte(`<doc xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><Field xsi:nil="true">123</Field></doc>`), &doc)
+		assert.NoError(t, err)
+		assert.False(t, doc.Field.valid)
+	})
+
+	t.Run("UnmarshalXML with empty element", func(t *testing.T) {
+		var doc xmlElementDoc
+		err := xml.Unmarshal([]byte(`<doc><Field></Field></doc>`), &doc)
+		assert.NoError(t, err)
+		assert.False(t, doc.Field.valid)
+	})
+
+	t.Run("UnmarshalXML with value", func(t *testing.T) {
+		var doc xmlElementDoc
+		err := xml.Unmarshal([]byte(`<doc><Field>123</Field></doc>`), &doc)
+		assert.NoError(t, err)
+		assert.True(t, doc.Field.valid)
+		assert.Equal(t, 123, doc.Field.value)
+	})
+
+	t.Run("MarshalXMLAttr with valid value", func(t *testing.T) {
+		data, err := xml.Marshal(xmlAttrDoc{Field: FromValue("value")})
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), `field="value"`)
+	})
+
+	t.Run("MarshalXMLAttr with null omits the attribute", func(t *testing.T) {
+		data, err := xml.Marshal(xmlAttrDoc{Field: Null[string]()})
+		assert.NoError(t, err)
+		assert.NotContains(t, string(data), "field=")
+	})
+
+	t.Run("UnmarshalXMLAttr with missing attribute leaves field null", func(t *testing.T) {
+		var doc xmlAttrDoc
+		err := xml.Unmarshal([]byte(`<doc></doc>`), &doc)
+		assert.NoError(t, err)
+		assert.False(t, doc.Field.valid)
+	})
+
+	t.Run("UnmarshalXMLAttr with value", func(t *testing.T) {
+		var doc xmlAttrDoc
+		err := xml.Unmarshal([]byte(`<doc field="value"></doc>`), &doc)
+		assert.NoError(t, err)
+		assert.True(t, doc.Field.valid)
+		assert.Equal(t, "value", doc.Field.value)
+	})
+}
+
+func TestNullable_Functional(t *testing.T) {
+	t.Run("Map with valid value", func(t *testing.T) {
+		n := FromValue(2)
+		result := Map(n, func(v int) string { return "value" })
+		assert.True(t, result.valid)
+		assert.Equal(t, "value", result.value)
+	})
+
+	t.Run("Map with null", func(t *testing.T) {
+		n := Null[int]()
+		result := Map(n, func(v int) string { return "value" })
+		assert.False(t, result.valid)
+	})
+
+	t.Run("FlatMap with valid value", func(t *testing.T) {
+		n := FromValue(4)
+		result := FlatMap(n, func(v int) Nullable[int] {
+			if v%2 == 0 {
+				return FromValue(v / 2)
+			}
+			return Null[int]()
+		})
+		assert.True(t, result.valid)
+		assert.Equal(t, 2, result.value)
+	})
+
+	t.Run("FlatMap with null", func(t *testing.T) {
+		n := Null[int]()
+		result := FlatMap(n, func(v int) Nullable[int] { return FromValue(v) })
+		assert.False(t, result.valid)
+	})
+
+	t.Run("Filter keeps value matching predicate", func(t *testing.T) {
+		n := FromValue(4)
+		result := Filter(n, func(v int) bool { return v%2 == 0 })
+		assert.True(t, result.valid)
+		assert.Equal(t, 4, result.value)
+	})
+
+	t.Run("Filter nulls out value failing predicate", func(t *testing.T) {
+		n := FromValue(3)
+		result := Filter(n, func(v int) bool { return v%2 == 0 })
+		assert.False(t, result.valid)
+	})
+
+	t.Run("Filter on null stays null", func(t *testing.T) {
+		n := Null[int]()
+		result := Filter(n, func(v int) bool { return true })
+		assert.False(t, result.valid)
+	})
+
+	t.Run("Equal with both null", func(t *testing.T) {
+		assert.True(t, Null[int]().Equal(Null[int](), func(a, b int) bool { return a == b }))
+	})
+
+	t.Run("Equal with one null", func(t *testing.T) {
+		assert.False(t, Null[int]().Equal(FromValue(1), func(a, b int) bool { return a == b }))
+	})
+
+	t.Run("Equal with both valid", func(t *testing.T) {
+		assert.True(t, FromValue(1).Equal(FromValue(1), func(a, b int) bool { return a == b }))
+		assert.False(t, FromValue(1).Equal(FromValue(2), func(a, b int) bool { return a == b }))
+	})
+
+	t.Run("EqualComparable", func(t *testing.T) {
+		assert.True(t, EqualComparable(FromValue("a"), FromValue("a")))
+		assert.False(t, EqualComparable(FromValue("a"), FromValue("b")))
+		assert.True(t, EqualComparable(Null[string](), Null[string]()))
+	})
+
+	t.Run("Ptr with valid value", func(t *testing.T) {
+		n := FromValue(42)
+		p := n.Ptr()
+		assert.NotNil(t, p)
+		assert.Equal(t, 42, *p)
+	})
+
+	t.Run("Ptr with null", func(t *testing.T) {
+		n := Null[int]()
+		assert.Nil(t, n.Ptr())
+	})
 }
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nullable
+
+import (
+	"sync/atomic"
+
+	"github.com/rlshukhov/nullable/internal/convert"
+)
+
+// NumericConversionMode controls how Scan handles a scanned value whose numeric kind
+// does not exactly match T, such as a database driver returning int64 for a
+// Nullable[int32] column.
+type NumericConversionMode int
+
+const (
+	// Strict requires an exact type match and is the default, preserving the original behavior.
+	Strict NumericConversionMode = NumericConversionMode(convert.Strict)
+	// Lossless allows numeric widening/narrowing, but only when the scanned value fits in
+	// T's range and, for float-to-int conversions, has no fractional part.
+	Lossless NumericConversionMode = NumericConversionMode(convert.Lossless)
+	// Convertible allows any numeric conversion via reflect.Value.Convert, without bounds
+	// or fractional-part checks.
+	Convertible NumericConversionMode = NumericConversionMode(convert.Convertible)
+)
+
+var numericConversionMode atomic.Int32
+
+// SetNumericConversion sets the package-wide numeric conversion mode used by Scan.
+// The default, Strict, requires scanned values to exactly match T's type. Safe to call
+// concurrently with Scan.
+func SetNumericConversion(mode NumericConversionMode) {
+	numericConversionMode.Store(int32(mode))
+}
+
+// currentNumericConversion returns the numeric conversion mode currently in effect.
+func currentNumericConversion() NumericConversionMode {
+	return NumericConversionMode(numericConversionMode.Load())
+}
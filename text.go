@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nullable
+
+import (
+	"encoding"
+
+	"github.com/rlshukhov/nullable/internal/convert"
+)
+
+// MarshalText implements the encoding.TextMarshaler interface for Nullable, allowing it
+// to be used as a map key and with formats such as encoding/xml.
+// A null Nullable marshals to an empty byte slice.
+func (n Nullable[T]) MarshalText() ([]byte, error) {
+	if !n.valid {
+		return []byte{}, nil
+	}
+
+	if marshaler, ok := any(n.value).(encoding.TextMarshaler); ok {
+		return marshaler.MarshalText()
+	}
+
+	return convert.ToText(n.value)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for Nullable.
+// An empty input results in a null Nullable.
+func (n *Nullable[T]) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		n.value = convert.ZeroValue[T]()
+		n.valid = false
+		return nil
+	}
+
+	if unmarshaler, ok := any(&n.value).(encoding.TextUnmarshaler); ok {
+		if err := unmarshaler.UnmarshalText(data); err != nil {
+			n.valid = false
+			return err
+		}
+		n.valid = true
+		return nil
+	}
+
+	value, err := convert.FromText[T](data)
+	if err != nil {
+		n.valid = false
+		return err
+	}
+
+	n.value = value
+	n.valid = true
+	return nil
+}
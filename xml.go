@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package nullable
+
+import (
+	"encoding/xml"
+
+	"github.com/rlshukhov/nullable/internal/convert"
+)
+
+// MarshalXML implements the xml.Marshaler interface for Nullable.
+// A null Nullable is encoded as an empty element carrying an xsi:nil="true" attribute.
+func (n Nullable[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.valid {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"})
+		if err := e.EncodeToken(start); err != nil {
+			return err
+		}
+		return e.EncodeToken(start.End())
+	}
+
+	if marshaler, ok := any(n.value).(xml.Marshaler); ok {
+		return marshaler.MarshalXML(e, start)
+	}
+
+	text, err := n.MarshalText()
+	if err != nil {
+		return err
+	}
+	return e.EncodeElement(string(text), start)
+}
+
+// UnmarshalXML implements the xml.Unmarshaler interface for Nullable.
+// An xsi:nil="true" attribute, or an empty element, results in a null Nullable.
+func (n *Nullable[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		if isXSINil(attr.Name) && (attr.Value == "true" || attr.Value == "1") {
+			n.value = convert.ZeroValue[T]()
+			n.valid = false
+			return d.Skip()
+		}
+	}
+
+	if unmarshaler, ok := any(&n.value).(xml.Unmarshaler); ok {
+		if err := unmarshaler.UnmarshalXML(d, start); err != nil {
+			n.valid = false
+			return err
+		}
+		n.valid = true
+		return nil
+	}
+
+	var raw string
+	if err := d.DecodeElement(&raw, &start); err != nil {
+		n.valid = false
+		return err
+	}
+
+	if raw == "" {
+		n.value = convert.ZeroValue[T]()
+		n.valid = false
+		return nil
+	}
+
+	return n.UnmarshalText([]byte(raw))
+}
+
+// MarshalXMLAttr implements the xml.MarshalerAttr interface for Nullable.
+// A null Nullable is omitted by returning the zero xml.Attr.
+func (n Nullable[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !n.valid {
+		return xml.Attr{}, nil
+	}
+
+	data, err := n.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(data)}, nil
+}
+
+// UnmarshalXMLAttr implements the xml.UnmarshalerAttr interface for Nullable.
+// It is only invoked by encoding/xml when the attribute is present.
+func (n *Nullable[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	if attr.Value == "" {
+		n.value = convert.ZeroValue[T]()
+		n.valid = false
+		return nil
+	}
+	return n.UnmarshalText([]byte(attr.Value))
+}
+
+// xsiNamespace is the XML Schema instance namespace URI. encoding/xml resolves a
+// declared prefix (e.g. xmlns:xsi="...") to this URI in Attr.Name.Space, rather than
+// keeping the literal prefix string.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// isXSINil reports whether name refers to the XML Schema instance "nil" attribute,
+// recognizing both a properly namespaced xsi:nil (xmlns:xsi declared) and the
+// degenerate case where the document never declares the xsi prefix, so encoding/xml
+// leaves the literal prefix "xsi" unresolved in Name.Space.
+func isXSINil(name xml.Name) bool {
+	return name.Local == "nil" && (name.Space == xsiNamespace || name.Space == "xsi")
+}
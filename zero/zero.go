@@ -0,0 +1,195 @@
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+// Package zero provides a generic Zero type that mirrors nullable.Nullable
+// but treats a Go zero value (the empty string, 0, false, a nil/empty slice,
+// etc.) as null. It is useful for form-style APIs where "empty" and "absent"
+// are not meaningfully distinct, while nullable.Nullable remains available
+// for cases where zero and null must be kept separate.
+package zero
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+
+	"github.com/rlshukhov/nullable/internal/convert"
+)
+
+// Zero represents a value of any type T whose Go zero value is treated as null.
+// The value field holds the actual value of type T.
+// valid indicates whether the value is set and non-zero (true) or is null (false).
+type Zero[T any] struct {
+	value T
+	valid bool
+}
+
+// FromValue creates a Zero with the given value. If value equals the zero value for T, valid is set to false.
+func FromValue[T any](value T) Zero[T] {
+	return Zero[T]{value: value, valid: !isZero(value)}
+}
+
+// Null creates a new Zero without a value (valid = false).
+func Null[T any]() Zero[T] {
+	return Zero[T]{valid: false}
+}
+
+// FromPointer creates a Zero from a pointer. If the pointer is nil, valid is set to false.
+func FromPointer[T any](value *T) Zero[T] {
+	if value == nil {
+		return Zero[T]{valid: false}
+	}
+	return FromValue(*value)
+}
+
+// OrElse returns the value if valid is true; otherwise, it returns the provided defaultVal.
+func (z Zero[T]) OrElse(defaultVal T) T {
+	if z.valid {
+		return z.value
+	}
+	return defaultVal
+}
+
+// GetValue returns the actual value T.
+func (z Zero[T]) GetValue() T {
+	return z.value
+}
+
+// IsNull checks if the value is null (valid = false).
+func (z Zero[T]) IsNull() bool {
+	return !z.valid
+}
+
+// HasValue checks if the value is not null (valid = true).
+func (z Zero[T]) HasValue() bool {
+	return z.valid
+}
+
+// Scan implements the sql.Scanner interface for Zero, allowing it to be used in database operations.
+func (z *Zero[T]) Scan(value any) error {
+	if value == nil {
+		z.value = convert.ZeroValue[T]()
+		z.valid = false
+		return nil
+	}
+
+	// Check if *T implements sql.Scanner
+	if scanner, ok := any(&z.value).(sql.Scanner); ok {
+		err := scanner.Scan(value)
+		if err != nil {
+			z.valid = false
+			return err
+		}
+		z.valid = !isZero(z.value)
+		return nil
+	}
+
+	// If T does not implement sql.Scanner, attempt type conversion
+	v, err := convert.ToType[T](value)
+	if err != nil {
+		z.valid = false
+		return err
+	}
+	z.value = v
+	z.valid = !isZero(v)
+	return nil
+}
+
+// Value implements the driver.Valuer interface for Zero, allowing it to be used in database operations.
+func (z Zero[T]) Value() (driver.Value, error) {
+	if !z.valid {
+		return nil, nil
+	}
+
+	// Check if T implements driver.Valuer
+	if valuer, ok := any(z.value).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	return convert.ToDriverValue(z.value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Zero.
+// Both a JSON null and the zero literal for T (e.g. "", 0, false) result in valid = false.
+func (z *Zero[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		z.valid = false
+		z.value = convert.ZeroValue[T]()
+		return nil
+	}
+
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		z.valid = false
+		return err
+	}
+
+	z.value = value
+	z.valid = !isZero(value)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface for Zero.
+// A null Zero marshals to T's zero value (e.g. "", 0, false, []) rather than JSON null.
+func (z Zero[T]) MarshalJSON() ([]byte, error) {
+	if z.valid {
+		return json.Marshal(z.value)
+	}
+	return json.Marshal(emptyValue[T]())
+}
+
+// UnmarshalYAML implements the unmarshaling of YAML data.
+// Both a YAML null and the zero literal for T result in valid = false.
+func (z *Zero[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var value T
+	if err := unmarshal(&value); err != nil {
+		z.valid = false
+		return err
+	}
+
+	z.value = value
+	z.valid = !isZero(value)
+	return nil
+}
+
+// MarshalYAML implements the marshaling of YAML data.
+// A null Zero marshals to T's zero value rather than YAML null.
+func (z Zero[T]) MarshalYAML() (any, error) {
+	if z.valid {
+		return z.value, nil
+	}
+	return emptyValue[T](), nil
+}
+
+// isZero reports whether v equals the zero value of T.
+func isZero[T any](v T) bool {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return true
+	}
+	return rv.IsZero()
+}
+
+// emptyValue returns the zero value for T, using a non-nil empty slice/map so that
+// MarshalJSON/MarshalYAML produce "[]"/"{}" instead of "null" for those kinds.
+func emptyValue[T any]() T {
+	zero := convert.ZeroValue[T]()
+	rv := reflect.ValueOf(&zero).Elem()
+	switch rv.Kind() {
+	case reflect.Slice:
+		if rv.IsNil() {
+			rv.Set(reflect.MakeSlice(rv.Type(), 0, 0))
+		}
+	case reflect.Map:
+		if rv.IsNil() {
+			rv.Set(reflect.MakeMap(rv.Type()))
+		}
+	}
+	return zero
+}
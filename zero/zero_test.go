@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: MPL-2.0
+
+/*
+ * This Source Code Form is subject to the terms of the Mozilla Public
+ * License, v. 2.0. If a copy of the MPL was not distributed with this
+ * file, You can obtain one at https://mozilla.org/MPL/2.0/.
+ */
+
+package zero
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+func TestZero_Constructors(t *testing.T) {
+	t.Run("FromValue with non-zero value", func(t *testing.T) {
+		z := FromValue("test")
+		assert.True(t, z.valid)
+		assert.Equal(t, "test", z.value)
+	})
+
+	t.Run("FromValue with zero value", func(t *testing.T) {
+		z := FromValue("")
+		assert.False(t, z.valid)
+		assert.Equal(t, "", z.value)
+	})
+
+	t.Run("Null constructor", func(t *testing.T) {
+		z := Null[int]()
+		assert.False(t, z.valid)
+		assert.Equal(t, 0, z.value)
+	})
+
+	t.Run("FromPointer with non-nil pointer to non-zero value", func(t *testing.T) {
+		val := 42
+		z := FromPointer(&val)
+		assert.True(t, z.valid)
+		assert.Equal(t, 42, z.value)
+	})
+
+	t.Run("FromPointer with nil pointer", func(t *testing.T) {
+		var val *string = nil
+		z := FromPointer(val)
+		assert.False(t, z.valid)
+	})
+}
+
+func TestZero_Methods(t *testing.T) {
+	t.Run("OrElse when valid is true", func(t *testing.T) {
+		z := FromValue("hello")
+		assert.Equal(t, "hello", z.OrElse("default"))
+	})
+
+	t.Run("OrElse when valid is false", func(t *testing.T) {
+		z := FromValue("")
+		assert.Equal(t, "default", z.OrElse("default"))
+	})
+
+	t.Run("IsNull and HasValue for zero value", func(t *testing.T) {
+		z := FromValue(0)
+		assert.True(t, z.IsNull())
+		assert.False(t, z.HasValue())
+	})
+
+	t.Run("IsNull and HasValue for non-zero value", func(t *testing.T) {
+		z := FromValue(1)
+		assert.False(t, z.IsNull())
+		assert.True(t, z.HasValue())
+	})
+}
+
+func TestZero_JSON(t *testing.T) {
+	t.Run("MarshalJSON with valid value", func(t *testing.T) {
+		z := FromValue("json test")
+		data, err := json.Marshal(z)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `"json test"`, string(data))
+	})
+
+	t.Run("MarshalJSON with zero string emits empty string, not null", func(t *testing.T) {
+		z := Null[string]()
+		data, err := json.Marshal(z)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `""`, string(data))
+	})
+
+	t.Run("MarshalJSON with zero int emits 0", func(t *testing.T) {
+		z := Null[int]()
+		data, err := json.Marshal(z)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `0`, string(data))
+	})
+
+	t.Run("MarshalJSON with zero slice emits empty array", func(t *testing.T) {
+		z := Null[[]int]()
+		data, err := json.Marshal(z)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `[]`, string(data))
+	})
+
+	t.Run("UnmarshalJSON with non-zero value", func(t *testing.T) {
+		var z Zero[int]
+		err := json.Unmarshal([]byte(`123`), &z)
+		assert.NoError(t, err)
+		assert.True(t, z.valid)
+		assert.Equal(t, 123, z.value)
+	})
+
+	t.Run("UnmarshalJSON with null", func(t *testing.T) {
+		var z Zero[int]
+		err := json.Unmarshal([]byte(`null`), &z)
+		assert.NoError(t, err)
+		assert.False(t, z.valid)
+	})
+
+	t.Run("UnmarshalJSON with zero literal", func(t *testing.T) {
+		var z Zero[int]
+		err := json.Unmarshal([]byte(`0`), &z)
+		assert.NoError(t, err)
+		assert.False(t, z.valid)
+	})
+}
+
+func TestZero_YAML(t *testing.T) {
+	t.Run("MarshalYAML with valid value", func(t *testing.T) {
+		z := FromValue("yaml test")
+		data, err := yaml.Marshal(z)
+		assert.NoError(t, err)
+		assert.Equal(t, "yaml test\n", string(data))
+	})
+
+	t.Run("MarshalYAML with zero value emits zero value, not null", func(t *testing.T) {
+		z := Null[string]()
+		data, err := yaml.Marshal(z)
+		assert.NoError(t, err)
+		assert.Equal(t, "\"\"\n", string(data))
+	})
+
+	t.Run("UnmarshalYAML with zero literal", func(t *testing.T) {
+		var z Zero[int]
+		err := yaml.Unmarshal([]byte(`0`), &z)
+		assert.NoError(t, err)
+		assert.False(t, z.valid)
+	})
+}
+
+func TestZero_DatabaseIntegration(t *testing.T) {
+	t.Run("Scan with non-zero value", func(t *testing.T) {
+		var z Zero[string]
+		err := z.Scan("database test")
+		assert.NoError(t, err)
+		assert.True(t, z.valid)
+		assert.Equal(t, "database test", z.value)
+	})
+
+	t.Run("Scan with empty string is treated as null", func(t *testing.T) {
+		var z Zero[string]
+		err := z.Scan("")
+		assert.NoError(t, err)
+		assert.False(t, z.valid)
+	})
+
+	t.Run("Scan with nil value", func(t *testing.T) {
+		var z Zero[int]
+		err := z.Scan(nil)
+		assert.NoError(t, err)
+		assert.False(t, z.valid)
+	})
+
+	t.Run("Value with non-zero value", func(t *testing.T) {
+		z := FromValue("driver value test")
+		val, err := z.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, "driver value test", val)
+	})
+
+	t.Run("Value with zero value returns nil", func(t *testing.T) {
+		z := Null[string]()
+		val, err := z.Value()
+		assert.NoError(t, err)
+		assert.Nil(t, val)
+	})
+}